@@ -81,6 +81,14 @@ type BulkJob struct {
 	ApiVersion      float64        `json:"apiVersion"`
 	LineEnding      string         `json:"lineEnding"`
 	ColumnDelimiter string         `json:"columnDelimiter"`
+
+	pollStrategy PollStrategy
+}
+
+// SetPollStrategy overrides the delay strategy used between polls in Wait.
+// If never called, Wait uses DefaultPollStrategy.
+func (job *BulkJob) SetPollStrategy(strategy PollStrategy) {
+	job.pollStrategy = strategy
 }
 
 func (job *BulkJob) GetStatus() (*BulkJobStatus, error) {
@@ -98,25 +106,14 @@ func (job *BulkJob) GetStatus() (*BulkJobStatus, error) {
 }
 
 func (job *BulkJob) Wait(ctx context.Context) error {
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-		status, err := job.GetStatus()
-		if err != nil {
-			return err
-		}
-		if status.State.IsFinished() {
-			return status.State.ToError()
-		}
-		time.Sleep(10 * time.Second)
-	}
+	return waitForState(ctx, job.pollStrategy, job.GetStatus)
 }
 
 func (job *BulkJob) GetResultSet(locator string) (*BulkJobResultSet, error) {
 	url := job.client.makeURL(fmt.Sprintf("jobs/query/%s/results", job.Id))
+	if locator != "" {
+		url += "?locator=" + locator
+	}
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -132,24 +129,30 @@ func (job *BulkJob) GetResultSet(locator string) (*BulkJobResultSet, error) {
 	}
 
 	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
 		b, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("failed to get result set from bulk job: %s body(%s)", resp.Status, string(b))
 	}
 
+	return readCSVResultSet(resp)
+}
+
+// readCSVResultSet drains a text/csv response body into a BulkJobResultSet,
+// following the Sforce-Locator/Sforce-NumberOfRecords header conventions
+// shared by the query, ingest, and Bulk API v1 result endpoints.
+func readCSVResultSet(resp *http.Response) (*BulkJobResultSet, error) {
+	defer resp.Body.Close()
+
 	out := &bytes.Buffer{}
 	if resp.ContentLength > 0 {
 		out = bytes.NewBuffer(make([]byte, 0, resp.ContentLength))
 	}
-
-	_, err = io.Copy(out, resp.Body)
-	if err != nil {
+	if _, err := io.Copy(out, resp.Body); err != nil {
 		return nil, err
 	}
 
 	// rows will simply be 0 if the header can't be parsed
-	rows, _ := strconv.Atoi(
-		resp.Header.Get("Sforce-NumberOfRecords"),
-	)
+	rows, _ := strconv.Atoi(resp.Header.Get("Sforce-NumberOfRecords"))
 	return &BulkJobResultSet{
 		Body: out,
 		Next: resp.Header.Get("Sforce-Locator"),
@@ -157,9 +160,11 @@ func (job *BulkJob) GetResultSet(locator string) (*BulkJobResultSet, error) {
 	}, nil
 }
 
+// Delete removes the job record from Salesforce. To stop a job without
+// removing its record, use Abort instead.
 func (job *BulkJob) Delete() error {
 	url := job.client.makeURL(fmt.Sprintf("jobs/query/%s", job.Id))
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
@@ -171,6 +176,7 @@ func (job *BulkJob) Delete() error {
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
 		b, _ := io.ReadAll(resp.Body)
@@ -178,3 +184,20 @@ func (job *BulkJob) Delete() error {
 	}
 	return nil
 }
+
+// Abort requests that Salesforce stop processing the job, leaving its
+// record (and any results already produced) in place. To remove the job
+// record entirely, use Delete instead.
+func (job *BulkJob) Abort() error {
+	data, err := json.Marshal(map[string]string{"state": string(Aborted)})
+	if err != nil {
+		return err
+	}
+
+	url := job.client.makeURL(fmt.Sprintf("jobs/query/%s", job.Id))
+	b, err := job.client.httpRequest("PATCH", url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, job)
+}
@@ -0,0 +1,157 @@
+package simpleforce
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// BulkRowIterator walks every page of a BulkJob's query results
+// transparently, decoding each page's CSV as it goes. It keeps at most one
+// page buffered in memory at a time.
+type BulkRowIterator struct {
+	ctx     context.Context
+	job     *BulkJob
+	locator string
+	done    bool
+
+	reader  *csv.Reader
+	columns []string
+	record  []string
+	err     error
+}
+
+// Rows returns an iterator over all result pages of a finished BulkJob
+// query, starting from the first page.
+func (job *BulkJob) Rows(ctx context.Context) (*BulkRowIterator, error) {
+	it := &BulkRowIterator{ctx: ctx, job: job}
+	if err := it.fetchPage(""); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// Columns returns the header row for the result set. Rows reads it from the
+// first page before returning, so it is available before the first Next.
+func (it *BulkRowIterator) Columns() []string {
+	return it.columns
+}
+
+// Next advances the iterator to the next record, fetching the next page of
+// results from the job when the current page is exhausted. It returns false
+// when iteration is complete or an error occurred; callers should check Err
+// in that case.
+func (it *BulkRowIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for {
+		record, err := it.reader.Read()
+		if err == io.EOF {
+			if it.done {
+				return false
+			}
+			if err := it.fetchPage(it.locator); err != nil {
+				it.err = err
+				return false
+			}
+			continue
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.record = record
+		return true
+	}
+}
+
+// Record returns the current row as a map keyed by column name.
+func (it *BulkRowIterator) Record() map[string]string {
+	row := make(map[string]string, len(it.columns))
+	for i, col := range it.columns {
+		if i < len(it.record) {
+			row[col] = it.record[i]
+		}
+	}
+	return row
+}
+
+// Scan copies the current row's values into dest, in column order. Each
+// entry in dest must be a *string; columns beyond len(dest) are ignored.
+func (it *BulkRowIterator) Scan(dest ...any) error {
+	for i, d := range dest {
+		if i >= len(it.record) {
+			continue
+		}
+		sp, ok := d.(*string)
+		if !ok {
+			return fmt.Errorf("bulk row iterator: dest[%d] must be *string, got %T", i, d)
+		}
+		*sp = it.record[i]
+	}
+	return nil
+}
+
+// AsSObject returns the current row as an SObject populated from the
+// result set's columns.
+func (it *BulkRowIterator) AsSObject() *SObject {
+	obj := &SObject{}
+	for col, val := range it.Record() {
+		obj.Set(col, val)
+	}
+	return obj
+}
+
+// Err returns the first error encountered during iteration, if any.
+func (it *BulkRowIterator) Err() error {
+	return it.err
+}
+
+func (it *BulkRowIterator) fetchPage(locator string) error {
+	select {
+	case <-it.ctx.Done():
+		return it.ctx.Err()
+	default:
+	}
+
+	result, err := it.job.GetResultSet(locator)
+	if err != nil {
+		return err
+	}
+
+	reader := csv.NewReader(result.Body)
+	reader.Comma = delimiterRune(it.job.ColumnDelimiter)
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	it.reader = reader
+	it.columns = header
+	it.locator = result.Next
+	it.done = result.Next == ""
+	return nil
+}
+
+// delimiterRune maps a BulkJob ColumnDelimiter value (e.g. "COMMA", "TAB")
+// to the rune encoding/csv expects, defaulting to comma.
+func delimiterRune(columnDelimiter string) rune {
+	switch columnDelimiter {
+	case "TAB":
+		return '\t'
+	case "PIPE":
+		return '|'
+	case "SEMICOLON":
+		return ';'
+	case "CARET":
+		return '^'
+	default:
+		return ','
+	}
+}
@@ -0,0 +1,19 @@
+package simpleforce
+
+import "testing"
+
+func TestDelimiterRune(t *testing.T) {
+	cases := map[string]rune{
+		"COMMA":     ',',
+		"TAB":       '\t',
+		"PIPE":      '|',
+		"SEMICOLON": ';',
+		"CARET":     '^',
+		"":          ',',
+	}
+	for in, want := range cases {
+		if got := delimiterRune(in); got != want {
+			t.Fatalf("delimiterRune(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package simpleforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// JobRecord is the durable checkpoint of a tracked BulkJob: enough to
+// resume waiting on it, or resume draining its results, after a process
+// restart.
+type JobRecord struct {
+	Id          string `json:"id"`
+	Object      string `json:"object"`
+	Operation   string `json:"operation"`
+	State       string `json:"state"`
+	LastLocator string `json:"lastLocator"`
+}
+
+// Store persists JobRecords so a BulkJobManager can survive process
+// restarts. Implementations must be safe for concurrent use.
+type Store interface {
+	Save(record JobRecord) error
+	Load() ([]JobRecord, error)
+	Delete(id string) error
+}
+
+// FileStore is a Store backed by a single JSON file containing all
+// JobRecords. NewBulkJobManager does not fall back to it: the caller passes
+// the Store to use.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileStore returns a FileStore persisting JobRecords to path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Save(record JobRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, r := range records {
+		if r.Id == record.Id {
+			records[i] = record
+			found = true
+			break
+		}
+	}
+	if !found {
+		records = append(records, record)
+	}
+	return s.persist(records)
+}
+
+func (s *FileStore) Load() ([]JobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+func (s *FileStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	out := records[:0]
+	for _, r := range records {
+		if r.Id != id {
+			out = append(out, r)
+		}
+	}
+	return s.persist(out)
+}
+
+func (s *FileStore) load() ([]JobRecord, error) {
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var records []JobRecord
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s *FileStore) persist(records []JobRecord) error {
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// ResultSink receives each page of a tracked job's query results as it
+// drains, in order, starting from wherever the job's JobRecord last left
+// off. jobId identifies which tracked job the page belongs to.
+type ResultSink func(jobId string, page *BulkJobResultSet) error
+
+// BulkJobManager tracks many in-flight BulkJobs concurrently, checkpointing
+// their progress to a Store so that work can be resumed after a process
+// restart via Recover, and handing off each drained result page to a
+// ResultSink as it becomes available.
+type BulkJobManager struct {
+	client   *Client
+	store    Store
+	sink     ResultSink
+	strategy PollStrategy
+
+	mu   sync.Mutex
+	jobs map[string]*BulkJob
+}
+
+// NewBulkJobManager returns a BulkJobManager that checkpoints to store,
+// hands off drained result pages to sink, and polls tracked jobs using
+// DefaultPollStrategy.
+func NewBulkJobManager(client *Client, store Store, sink ResultSink) *BulkJobManager {
+	return &BulkJobManager{
+		client:   client,
+		store:    store,
+		sink:     sink,
+		strategy: DefaultPollStrategy,
+		jobs:     make(map[string]*BulkJob),
+	}
+}
+
+// SetPollStrategy overrides the PollStrategy applied to jobs tracked from
+// this point on.
+func (m *BulkJobManager) SetPollStrategy(strategy PollStrategy) {
+	m.strategy = strategy
+}
+
+// Track registers job with the manager, checkpoints its initial state, and
+// waits for it to complete in the background, draining and checkpointing
+// its results as they become available.
+func (m *BulkJobManager) Track(ctx context.Context, job *BulkJob) error {
+	job.SetPollStrategy(m.strategy)
+
+	m.mu.Lock()
+	m.jobs[job.Id] = job
+	m.mu.Unlock()
+
+	record := JobRecord{Id: job.Id, Object: job.Object, Operation: job.Operation, State: job.State}
+	if err := m.store.Save(record); err != nil {
+		m.forget(job.Id)
+		return err
+	}
+
+	go m.waitAndDrain(ctx, job, record)
+	return nil
+}
+
+// Recover re-hydrates tracked jobs from the store and resumes them: jobs
+// still in flight resume waiting, and jobs that had already finished resume
+// result draining from their last persisted locator.
+func (m *BulkJobManager) Recover(ctx context.Context) error {
+	records, err := m.store.Load()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		job := &BulkJob{
+			client:    m.client,
+			Id:        record.Id,
+			Object:    record.Object,
+			Operation: record.Operation,
+			State:     record.State,
+		}
+		job.SetPollStrategy(m.strategy)
+
+		m.mu.Lock()
+		m.jobs[job.Id] = job
+		m.mu.Unlock()
+
+		if JobStateEnum(record.State).IsFinished() {
+			go m.drainAndForget(ctx, job, record)
+			continue
+		}
+		go m.waitAndDrain(ctx, job, record)
+	}
+	return nil
+}
+
+func (m *BulkJobManager) waitAndDrain(ctx context.Context, job *BulkJob, record JobRecord) {
+	if err := job.Wait(ctx); err != nil {
+		if ctx.Err() != nil {
+			// Wait was interrupted (e.g. process shutdown), not an actual
+			// job failure. Leave the persisted state untouched so the next
+			// Recover resumes Wait instead of draining a job that never
+			// actually finished.
+			m.forget(job.Id)
+			return
+		}
+
+		// Wait only returns a non-context error when GetStatus failed
+		// transiently or the job reached a terminal Aborted/Failed state.
+		// Re-check status to tell those apart before checkpointing; on a
+		// transient error, leave the record as-is so Recover retries Wait.
+		if status, statusErr := job.GetStatus(); statusErr == nil && status.State.IsFinished() {
+			record.State = string(status.State)
+			_ = m.store.Save(record)
+			m.forget(job.Id)
+		}
+		return
+	}
+
+	record.State = string(JobComplete)
+	m.drainAndForget(ctx, job, record)
+}
+
+func (m *BulkJobManager) drainAndForget(ctx context.Context, job *BulkJob, record JobRecord) {
+	if err := m.drain(ctx, job, &record); err != nil {
+		_ = m.store.Save(record)
+		return
+	}
+	_ = m.store.Delete(job.Id)
+	m.forget(job.Id)
+}
+
+// drain walks every result page of job from record.LastLocator onward,
+// handing each page to the manager's ResultSink and checkpointing the
+// locator afterward so a restart resumes from where it left off rather than
+// re-reading already-consumed pages or re-delivering them to the sink.
+func (m *BulkJobManager) drain(ctx context.Context, job *BulkJob, record *JobRecord) error {
+	return m.drainResults(ctx, job.Id, job.GetResultSet, record)
+}
+
+// drainResults is the core of drain, taking getResultSet as a parameter so
+// it can be exercised without a live BulkJob/Client.
+func (m *BulkJobManager) drainResults(ctx context.Context, jobId string, getResultSet func(locator string) (*BulkJobResultSet, error), record *JobRecord) error {
+	if m.sink == nil {
+		return fmt.Errorf("bulk job manager: no ResultSink configured, cannot drain job %s", jobId)
+	}
+
+	locator := record.LastLocator
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		result, err := getResultSet(locator)
+		if err != nil {
+			return err
+		}
+
+		if err := m.sink(jobId, result); err != nil {
+			return err
+		}
+
+		locator = result.Next
+		record.LastLocator = locator
+		if err := m.store.Save(*record); err != nil {
+			return err
+		}
+		if locator == "" {
+			return nil
+		}
+	}
+}
+
+func (m *BulkJobManager) forget(id string) {
+	m.mu.Lock()
+	delete(m.jobs, id)
+	m.mu.Unlock()
+}
@@ -0,0 +1,207 @@
+package simpleforce
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileStoreSaveLoadDelete(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "jobs.json"))
+
+	if records, err := store.Load(); err != nil || len(records) != 0 {
+		t.Fatalf("Load() on empty store = %v, %v; want empty, nil", records, err)
+	}
+
+	if err := store.Save(JobRecord{Id: "1", Object: "Account", State: "InProgress"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(JobRecord{Id: "2", Object: "Contact", State: "InProgress"}); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+
+	if err := store.Save(JobRecord{Id: "1", Object: "Account", State: "JobComplete"}); err != nil {
+		t.Fatal(err)
+	}
+	records, err = store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records after update, want 2", len(records))
+	}
+	for _, r := range records {
+		if r.Id == "1" && r.State != "JobComplete" {
+			t.Fatalf("record 1 state = %s, want JobComplete", r.State)
+		}
+	}
+
+	if err := store.Delete("1"); err != nil {
+		t.Fatal(err)
+	}
+	records, err = store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Id != "2" {
+		t.Fatalf("got %v after delete, want only record 2", records)
+	}
+}
+
+// TestWaitAndDrainLeavesRecordResumableOnCancellation guards against
+// treating an interrupted Wait (e.g. context canceled on process shutdown)
+// as a permanent job failure: the persisted record must stay resumable
+// rather than being checkpointed as Failed.
+func TestWaitAndDrainLeavesRecordResumableOnCancellation(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "jobs.json"))
+	manager := NewBulkJobManager(nil, store, func(string, *BulkJobResultSet) error {
+		t.Fatal("sink should not be called: Wait is interrupted before any draining occurs")
+		return nil
+	})
+
+	record := JobRecord{Id: "750x0000000001", Object: "Account", Operation: "query", State: "InProgress"}
+	if err := store.Save(record); err != nil {
+		t.Fatal(err)
+	}
+	job := &BulkJob{Id: record.Id, Object: record.Object, Operation: record.Operation, State: record.State}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Calling the unexported method directly (rather than via Track's
+	// goroutine) keeps this deterministic: job.Wait sees the already
+	// canceled context on its first check and returns without ever
+	// touching job.client, so a nil client here is safe.
+	manager.waitAndDrain(ctx, job, record)
+
+	records, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].State != "InProgress" {
+		t.Fatalf("record after interrupted Wait = %+v, want State=InProgress (resumable, not Failed)", records)
+	}
+}
+
+// TestRecoverResumesWaitAfterInterruption checks that Recover, seeing a
+// record left in a non-finished state by an interrupted Wait, resumes
+// waiting on the job rather than draining it as if it had completed.
+func TestRecoverResumesWaitAfterInterruption(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "jobs.json"))
+	var sinkCalled atomic.Bool
+	manager := NewBulkJobManager(nil, store, func(string, *BulkJobResultSet) error {
+		sinkCalled.Store(true)
+		return nil
+	})
+
+	record := JobRecord{Id: "750x0000000002", Object: "Account", Operation: "query", State: "InProgress"}
+	if err := store.Save(record); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := manager.Recover(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Recover dispatches waitAndDrain in the background; wait for it to
+	// observe the already-canceled context and forget the job.
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		manager.mu.Lock()
+		_, stillTracked := manager.jobs[record.Id]
+		manager.mu.Unlock()
+		if !stillTracked {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if sinkCalled.Load() {
+		t.Fatal("sink should not be called: Wait is interrupted before any draining occurs")
+	}
+
+	records, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].State != "InProgress" {
+		t.Fatalf("record after Recover = %+v, want State=InProgress (still resumable, not Failed or dropped)", records)
+	}
+}
+
+// TestDrainResultsDeliversPagesToSink checks that drainResults hands every
+// result page to the manager's ResultSink, in order, and leaves
+// record.LastLocator empty once the final page has been drained.
+func TestDrainResultsDeliversPagesToSink(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "jobs.json"))
+
+	var delivered []string
+	manager := NewBulkJobManager(nil, store, func(jobId string, page *BulkJobResultSet) error {
+		delivered = append(delivered, fmt.Sprintf("%s:%s", jobId, page.Body.String()))
+		return nil
+	})
+
+	pages := map[string]*BulkJobResultSet{
+		"":  {Body: bytes.NewBufferString("page1"), Next: "a"},
+		"a": {Body: bytes.NewBufferString("page2"), Next: ""},
+	}
+	getResultSet := func(locator string) (*BulkJobResultSet, error) {
+		page, ok := pages[locator]
+		if !ok {
+			t.Fatalf("unexpected locator %q", locator)
+		}
+		return page, nil
+	}
+
+	record := &JobRecord{Id: "750x0000000003", Object: "Account", Operation: "query", State: "JobComplete"}
+	if err := manager.drainResults(context.Background(), record.Id, getResultSet, record); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"750x0000000003:page1", "750x0000000003:page2"}
+	if len(delivered) != len(want) {
+		t.Fatalf("got %d pages delivered, want %d: %v", len(delivered), len(want), delivered)
+	}
+	for i, d := range delivered {
+		if d != want[i] {
+			t.Errorf("page %d = %q, want %q", i, d, want[i])
+		}
+	}
+
+	if record.LastLocator != "" {
+		t.Fatalf("record.LastLocator after full drain = %q, want empty (drain reached the end)", record.LastLocator)
+	}
+}
+
+// TestDrainResultsRequiresSink checks that drainResults fails loudly instead
+// of silently discarding result pages when the manager has no ResultSink
+// configured.
+func TestDrainResultsRequiresSink(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "jobs.json"))
+	manager := NewBulkJobManager(nil, store, nil)
+
+	getResultSet := func(locator string) (*BulkJobResultSet, error) {
+		t.Fatal("getResultSet should not be called when there is no sink to deliver to")
+		return nil, nil
+	}
+
+	record := &JobRecord{Id: "750x0000000004", Object: "Account", Operation: "query", State: "JobComplete"}
+	if err := manager.drainResults(context.Background(), record.Id, getResultSet, record); err == nil {
+		t.Fatal("drainResults() with no sink = nil error, want an error")
+	}
+}
@@ -0,0 +1,203 @@
+package simpleforce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// PKChunkingOptions configures the Sforce-Enable-PKChunking header sent
+// when creating a BulkJobV1, splitting a query into parallel batches by
+// parent key instead of relying on Bulk 2.0's single-query locator
+// pagination. Needed for extracts beyond ~50M rows that would otherwise
+// time out.
+type PKChunkingOptions struct {
+	// ChunkSize is the number of records per chunk. Salesforce defaults to
+	// 100000 and caps at 250000.
+	ChunkSize int
+	// Parent is the parent object name, required when PK chunking a child
+	// object (e.g. "Account" when chunking a query against "Contact").
+	Parent string
+}
+
+func (o PKChunkingOptions) header() string {
+	h := "true"
+	if o.ChunkSize > 0 {
+		h = fmt.Sprintf("chunkSize=%d", o.ChunkSize)
+	}
+	if o.Parent != "" {
+		h += fmt.Sprintf("; parent=%s", o.Parent)
+	}
+	return h
+}
+
+// BulkJobV1 drives the Bulk API v1 asynchronous job flow
+// (/services/async/{apiVersion}/job). Prefer BulkJob (Bulk API 2.0) unless
+// PK chunking is required for an extract too large for 2.0's single-query
+// model.
+type BulkJobV1 struct {
+	client      *Client
+	Id          string `json:"id"`
+	Object      string `json:"object"`
+	Operation   string `json:"operation"`
+	ContentType string `json:"contentType"`
+	State       string `json:"state"`
+}
+
+// BulkBatchV1 is a single batch within a BulkJobV1.
+type BulkBatchV1 struct {
+	Id                     string `json:"id"`
+	JobId                  string `json:"jobId"`
+	State                  string `json:"state"`
+	NumberRecordsProcessed int64  `json:"numberRecordsProcessed"`
+}
+
+// makeAsyncURL builds a URL under the Bulk API v1 async endpoint, which is
+// a sibling of the REST Data API root that makeURL targets.
+func (client *Client) makeAsyncURL(path string) string {
+	base := strings.Replace(client.makeURL(""), "/services/data/", "/services/async/", 1)
+	return strings.TrimRight(base, "/") + "/" + path
+}
+
+// NewBulkV1QueryJob creates a Bulk API v1 query job for object. If pk is
+// non-nil, PK chunking is requested via the Sforce-Enable-PKChunking
+// header.
+func (client *Client) NewBulkV1QueryJob(object string, pk *PKChunkingOptions) (*BulkJobV1, error) {
+	body := map[string]string{
+		"operation":   "query",
+		"object":      object,
+		"contentType": "CSV",
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", client.makeAsyncURL("job"), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer "+client.sessionID)
+	if pk != nil {
+		req.Header.Add("Sforce-Enable-PKChunking", pk.header())
+	}
+
+	b, err := client.doAsyncRequest(req, "create bulk v1 job")
+	if err != nil {
+		return nil, err
+	}
+
+	job := &BulkJobV1{client: client}
+	if err := json.Unmarshal(b, job); err != nil {
+		sfErr := ParseSalesforceError(0, b)
+		return nil, errors.Join(sfErr, err)
+	}
+	return job, nil
+}
+
+// AddBatch submits soql as a new batch on the job.
+func (job *BulkJobV1) AddBatch(soql string) (*BulkBatchV1, error) {
+	url := job.client.makeAsyncURL(fmt.Sprintf("job/%s/batch", job.Id))
+	req, err := http.NewRequest("POST", url, strings.NewReader(soql))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "text/csv")
+	req.Header.Add("Authorization", "Bearer "+job.client.sessionID)
+
+	b, err := job.client.doAsyncRequest(req, "add batch to bulk v1 job")
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &BulkBatchV1{}
+	if err := json.Unmarshal(b, batch); err != nil {
+		sfErr := ParseSalesforceError(0, b)
+		return nil, errors.Join(sfErr, err)
+	}
+	return batch, nil
+}
+
+// CloseJob marks the job closed, indicating no further batches will be
+// added and processing may begin.
+func (job *BulkJobV1) CloseJob() error {
+	data, err := json.Marshal(map[string]string{"state": "Closed"})
+	if err != nil {
+		return err
+	}
+
+	url := job.client.makeAsyncURL(fmt.Sprintf("job/%s", job.Id))
+	b, err := job.client.httpRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, job)
+}
+
+// GetBatches returns every batch submitted to the job, including their
+// current state.
+func (job *BulkJobV1) GetBatches() ([]BulkBatchV1, error) {
+	url := job.client.makeAsyncURL(fmt.Sprintf("job/%s/batch", job.Id))
+	b, err := job.client.httpRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		BatchInfo []BulkBatchV1 `json:"batchInfo"`
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		sfErr := ParseSalesforceError(0, b)
+		return nil, errors.Join(sfErr, err)
+	}
+	return result.BatchInfo, nil
+}
+
+// GetBatchResult fetches the CSV body for resultId, one of the result ids
+// returned for a completed batch (via GET job/{id}/batch/{batchId}/result).
+func (job *BulkJobV1) GetBatchResult(batchId, resultId string) (*BulkJobResultSet, error) {
+	url := job.client.makeAsyncURL(fmt.Sprintf("job/%s/batch/%s/result/%s", job.Id, batchId, resultId))
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "text/csv")
+	req.Header.Add("Authorization", "Bearer "+job.client.sessionID)
+
+	resp, err := job.client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get bulk v1 batch result: %s body(%s)", resp.Status, string(b))
+	}
+
+	return readCSVResultSet(resp)
+}
+
+// doAsyncRequest executes req against the Bulk API v1 async endpoint,
+// returning the response body or an error describing what action failed.
+func (client *Client) doAsyncRequest(req *http.Request, action string) ([]byte, error) {
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("failed to %s: %s body(%s)", action, resp.Status, string(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,20 @@
+package simpleforce
+
+import "testing"
+
+func TestPKChunkingOptionsHeader(t *testing.T) {
+	cases := []struct {
+		opts PKChunkingOptions
+		want string
+	}{
+		{PKChunkingOptions{}, "true"},
+		{PKChunkingOptions{Parent: "Account"}, "true; parent=Account"},
+		{PKChunkingOptions{ChunkSize: 250000}, "chunkSize=250000"},
+		{PKChunkingOptions{ChunkSize: 100000, Parent: "Account"}, "chunkSize=100000; parent=Account"},
+	}
+	for _, c := range cases {
+		if got := c.opts.header(); got != c.want {
+			t.Fatalf("header() = %q, want %q", got, c.want)
+		}
+	}
+}
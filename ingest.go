@@ -0,0 +1,278 @@
+package simpleforce
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// newLineScanner returns a bufio.Scanner over r configured with a buffer
+// large enough to accommodate individual CSV rows well beyond the default
+// 64 KiB scanner limit.
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxIngestBatchBytes)
+	return sc
+}
+
+// maxIngestBatchBytes keeps individual ingest batch uploads comfortably under
+// the Bulk API 2.0 150 MB-per-batch limit while leaving headroom for the
+// header row and any multi-byte characters introduced by chunking on line
+// boundaries.
+const maxIngestBatchBytes = 100 * 1024 * 1024
+
+type IngestOperation string
+
+const (
+	IngestInsert IngestOperation = "insert"
+	IngestUpdate IngestOperation = "update"
+	IngestUpsert IngestOperation = "upsert"
+	IngestDelete IngestOperation = "delete"
+)
+
+// IngestJob represents a Bulk API 2.0 ingest job created via
+// Client.NewBulkIngestJob. It mirrors BulkJob's query counterpart but drives
+// data into Salesforce instead of out of it.
+type IngestJob struct {
+	client              *Client
+	Id                  string         `json:"id"`
+	Operation           string         `json:"operation"`
+	Object              string         `json:"object"`
+	ExternalIdFieldName string         `json:"externalIdFieldName,omitempty"`
+	ContentType         string         `json:"contentType"`
+	LineEnding          string         `json:"lineEnding"`
+	ColumnDelimiter     string         `json:"columnDelimiter"`
+	State               string         `json:"state"`
+	CreatedById         string         `json:"createdById"`
+	CreatedDate         SalesforceTime `json:"createdDate"`
+	SystemModstamp      SalesforceTime `json:"systemModstamp"`
+	ApiVersion          float64        `json:"apiVersion"`
+
+	pollStrategy PollStrategy
+}
+
+// SetPollStrategy overrides the delay strategy used between polls in Wait.
+// If never called, Wait uses DefaultPollStrategy.
+func (job *IngestJob) SetPollStrategy(strategy PollStrategy) {
+	job.pollStrategy = strategy
+}
+
+// NewBulkIngestJob creates a Bulk API 2.0 ingest job for object using the
+// given operation. externalIdFieldName is required for IngestUpsert and
+// ignored otherwise.
+func (client *Client) NewBulkIngestJob(object string, operation IngestOperation, externalIdFieldName string) (*IngestJob, error) {
+	body := map[string]interface{}{
+		"object":          object,
+		"operation":       string(operation),
+		"contentType":     "CSV",
+		"lineEnding":      "LF",
+		"columnDelimiter": "COMMA",
+	}
+	if externalIdFieldName != "" {
+		body["externalIdFieldName"] = externalIdFieldName
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	url := client.makeURL("jobs/ingest")
+	b, err := client.httpRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	job := &IngestJob{client: client}
+	if err := json.Unmarshal(b, job); err != nil {
+		sfErr := ParseSalesforceError(0, b)
+		return nil, errors.Join(sfErr, err)
+	}
+	return job, nil
+}
+
+// Upload streams r to the ingest job as one or more CSV batches, splitting
+// on line boundaries so no batch exceeds the Bulk API 2.0 per-batch limit.
+// The first line of r must be the CSV header; it is repeated at the top of
+// every batch.
+func (job *IngestJob) Upload(r io.Reader) error {
+	return splitCSVBatches(r, maxIngestBatchBytes, job.uploadBatch)
+}
+
+// splitCSVBatches reads r (whose first line must be a CSV header) and calls
+// emit, in order, with each batch of at most maxBatchBytes bytes; every
+// batch carries its own copy of the header. A single row larger than
+// maxBatchBytes is still emitted whole, as a batch of its own, rather than
+// being split mid-row. Batches containing no data rows (e.g. header-only
+// input) are never emitted.
+func splitCSVBatches(r io.Reader, maxBatchBytes int, emit func([]byte) error) error {
+	sc := newLineScanner(r)
+
+	var header []byte
+	if sc.Scan() {
+		header = append(header, sc.Bytes()...)
+		header = append(header, '\n')
+	} else {
+		if err := sc.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("ingest upload: empty input, expected a CSV header")
+	}
+
+	batch := append([]byte(nil), header...)
+	sawDataRow := false
+	flush := func() error {
+		if !sawDataRow {
+			return nil
+		}
+		if err := emit(batch); err != nil {
+			return err
+		}
+		batch = append([]byte(nil), header...)
+		sawDataRow = false
+		return nil
+	}
+
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(batch)+len(line)+1 > maxBatchBytes {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		batch = append(batch, line...)
+		batch = append(batch, '\n')
+		sawDataRow = true
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+func (job *IngestJob) uploadBatch(b []byte) error {
+	url := job.client.makeURL(fmt.Sprintf("jobs/ingest/%s/batches", job.Id))
+	req, err := http.NewRequest("PUT", url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "text/csv")
+	req.Header.Add("Authorization", "Bearer "+job.client.sessionID)
+
+	resp, err := job.client.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to upload ingest batch: %s body(%s)", resp.Status, string(respBody))
+	}
+	return nil
+}
+
+// Close marks the ingest job's batches as complete, instructing Salesforce
+// to begin processing. No further batches may be uploaded afterwards.
+func (job *IngestJob) Close() error {
+	return job.patchState(UploadComplete)
+}
+
+// Abort cancels the ingest job instead of processing its uploaded batches.
+func (job *IngestJob) Abort() error {
+	return job.patchState(Aborted)
+}
+
+func (job *IngestJob) patchState(state JobStateEnum) error {
+	data, err := json.Marshal(map[string]string{"state": string(state)})
+	if err != nil {
+		return err
+	}
+
+	url := job.client.makeURL(fmt.Sprintf("jobs/ingest/%s", job.Id))
+	b, err := job.client.httpRequest("PATCH", url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, job)
+}
+
+// GetStatus fetches the current state of the ingest job.
+func (job *IngestJob) GetStatus() (*BulkJobStatus, error) {
+	url := job.client.makeURL(fmt.Sprintf("jobs/ingest/%s", job.Id))
+	b, err := job.client.httpRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := &BulkJobStatus{}
+	if err := json.Unmarshal(b, status); err != nil {
+		sfErr := ParseSalesforceError(0, b)
+		return nil, errors.Join(sfErr, err)
+	}
+	return status, nil
+}
+
+// Wait blocks until the ingest job reaches a finished state, returning an
+// error if it failed or was aborted.
+func (job *IngestJob) Wait(ctx context.Context) error {
+	return waitForState(ctx, job.pollStrategy, job.GetStatus)
+}
+
+// IngestJobResults holds the CSV result buffers for a finished ingest job.
+type IngestJobResults struct {
+	Successful  *BulkJobResultSet
+	Failed      *BulkJobResultSet
+	Unprocessed *BulkJobResultSet
+}
+
+// Results fetches the successful, failed, and unprocessed record CSVs for a
+// finished ingest job.
+func (job *IngestJob) Results() (*IngestJobResults, error) {
+	successful, err := job.getResultSet("successfulResults")
+	if err != nil {
+		return nil, err
+	}
+	failed, err := job.getResultSet("failedResults")
+	if err != nil {
+		return nil, err
+	}
+	unprocessed, err := job.getResultSet("unprocessedrecords")
+	if err != nil {
+		return nil, err
+	}
+	return &IngestJobResults{
+		Successful:  successful,
+		Failed:      failed,
+		Unprocessed: unprocessed,
+	}, nil
+}
+
+func (job *IngestJob) getResultSet(endpoint string) (*BulkJobResultSet, error) {
+	url := job.client.makeURL(fmt.Sprintf("jobs/ingest/%s/%s", job.Id, endpoint))
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Accept", "text/csv")
+	req.Header.Add("Authorization", "Bearer "+job.client.sessionID)
+
+	resp, err := job.client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get %s from ingest job: %s body(%s)", endpoint, resp.Status, string(b))
+	}
+
+	return readCSVResultSet(resp)
+}
@@ -0,0 +1,75 @@
+package simpleforce
+
+import (
+	"strings"
+	"testing"
+)
+
+func collectBatches(t *testing.T, csv string, maxBatchBytes int) []string {
+	t.Helper()
+	var batches []string
+	err := splitCSVBatches(strings.NewReader(csv), maxBatchBytes, func(b []byte) error {
+		batches = append(batches, string(b))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return batches
+}
+
+func TestSplitCSVBatchesMultiBatch(t *testing.T) {
+	csv := "id,name\n1,a\n2,b\n3,c\n"
+	// Each data row is "N,x\n" (4 bytes) and the header is "id,name\n" (8
+	// bytes); size the limit so exactly two rows fit alongside the header
+	// per batch, forcing a third row into its own batch.
+	batches := collectBatches(t, csv, len("id,name\n")+2*len("1,a\n"))
+
+	want := []string{
+		"id,name\n1,a\n2,b\n",
+		"id,name\n3,c\n",
+	}
+	if len(batches) != len(want) {
+		t.Fatalf("got %d batches, want %d: %q", len(batches), len(want), batches)
+	}
+	for i, b := range batches {
+		if b != want[i] {
+			t.Errorf("batch %d = %q, want %q", i, b, want[i])
+		}
+	}
+}
+
+func TestSplitCSVBatchesHeaderOnly(t *testing.T) {
+	batches := collectBatches(t, "id,name\n", maxIngestBatchBytes)
+	if len(batches) != 0 {
+		t.Fatalf("got %d batches for header-only input, want 0: %q", len(batches), batches)
+	}
+}
+
+func TestSplitCSVBatchesOversizedRow(t *testing.T) {
+	header := "id,name\n"
+	small := "1,a\n"
+	oversized := "2," + strings.Repeat("x", 100) + "\n"
+	csv := header + small + oversized
+
+	// The limit comfortably fits the header plus the small row, but not the
+	// oversized row alongside it.
+	maxBatchBytes := len(header) + len(small) + 10
+	batches := collectBatches(t, csv, maxBatchBytes)
+
+	want := []string{
+		header + small,
+		header + oversized,
+	}
+	if len(batches) != len(want) {
+		t.Fatalf("got %d batches, want %d: %q", len(batches), len(want), batches)
+	}
+	for i, b := range batches {
+		if b != want[i] {
+			t.Errorf("batch %d = %q, want %q", i, b, want[i])
+		}
+	}
+	if len(batches[1]) <= maxBatchBytes {
+		t.Fatalf("expected the oversized-row batch to exceed maxBatchBytes (%d), got %d bytes", maxBatchBytes, len(batches[1]))
+	}
+}
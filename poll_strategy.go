@@ -0,0 +1,82 @@
+package simpleforce
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// PollStrategy determines how long to wait between status checks while
+// BulkJob.Wait or IngestJob.Wait polls for job completion. attempt is the
+// zero-based index of the poll just made (0 after the first poll), and status
+// is the result of that poll.
+type PollStrategy interface {
+	NextDelay(attempt int, status *BulkJobStatus) time.Duration
+}
+
+// ExponentialBackoff is the default PollStrategy: delay doubles with each
+// attempt up to Cap, with up to +/-Jitter fractional jitter applied so that
+// many jobs polling in lockstep don't all hit the API at once.
+type ExponentialBackoff struct {
+	Base   time.Duration
+	Cap    time.Duration
+	Jitter float64
+}
+
+// DefaultPollStrategy is used by BulkJob.Wait and IngestJob.Wait when no
+// PollStrategy has been set: base=2s, cap=2m, +/-20% jitter.
+var DefaultPollStrategy PollStrategy = ExponentialBackoff{
+	Base:   2 * time.Second,
+	Cap:    2 * time.Minute,
+	Jitter: 0.2,
+}
+
+func (b ExponentialBackoff) NextDelay(attempt int, _ *BulkJobStatus) time.Duration {
+	delay := b.Cap
+	if attempt < 63 { // avoid overflowing the shift
+		if d := b.Base << attempt; d > 0 && d < b.Cap {
+			delay = d
+		}
+	}
+
+	if b.Jitter <= 0 {
+		return delay
+	}
+	spread := float64(delay) * b.Jitter
+	offset := (rand.Float64()*2 - 1) * spread
+	delay += time.Duration(offset)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// waitForState polls getStatus, using strategy to space out attempts, until
+// the job reaches a finished state. It backs BulkJob.Wait and IngestJob.Wait,
+// which differ only in which job's GetStatus they poll.
+func waitForState(ctx context.Context, strategy PollStrategy, getStatus func() (*BulkJobStatus, error)) error {
+	if strategy == nil {
+		strategy = DefaultPollStrategy
+	}
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		status, err := getStatus()
+		if err != nil {
+			return err
+		}
+		if status.State.IsFinished() {
+			return status.State.ToError()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(strategy.NextDelay(attempt, status)):
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package simpleforce
+
+import "testing"
+
+func TestExponentialBackoffNextDelay(t *testing.T) {
+	b := ExponentialBackoff{Base: 2, Cap: 100, Jitter: 0.2}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		d := b.NextDelay(attempt, nil)
+		if d < 0 {
+			t.Fatalf("attempt %d: delay %d must not be negative", attempt, d)
+		}
+		if max := int64(float64(b.Cap) * (1 + b.Jitter)); int64(d) > max {
+			t.Fatalf("attempt %d: delay %d exceeds cap+jitter %d", attempt, d, max)
+		}
+	}
+}
+
+func TestExponentialBackoffNoJitter(t *testing.T) {
+	b := ExponentialBackoff{Base: 2, Cap: 100}
+	if got := b.NextDelay(0, nil); got != 2 {
+		t.Fatalf("NextDelay(0) = %d, want 2", got)
+	}
+	if got := b.NextDelay(2, nil); got != 8 {
+		t.Fatalf("NextDelay(2) = %d, want 8", got)
+	}
+}